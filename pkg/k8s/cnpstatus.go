@@ -19,11 +19,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
 	cilium_v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	cnpmetrics "github.com/cilium/cilium/pkg/k8s/metrics"
 	"github.com/cilium/cilium/pkg/k8s/types"
 	k8sversion "github.com/cilium/cilium/pkg/k8s/version"
 	"github.com/cilium/cilium/pkg/kvstore"
@@ -32,20 +35,95 @@ import (
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/sirupsen/logrus"
 
+	"golang.org/x/time/rate"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
 )
 
-// CNPStatusEventHandler handles status updates events for all CNPs in the
-// cluster. Upon creation of CNPs, it will start a controller for that CNP which
-// handles sending of updates for that CNP to the Kubernetes API server. Upon
-// receiving events from the key-value store, it will send the update for the
-// CNP corresponding to the status update to the controller for that CNP.
+// PolicyStatusEventHandler is implemented by CNPStatusEventHandler and
+// CCNPStatusEventHandler. It manages the per-policy controllers which relay
+// node status updates collected from the kvstore to the Kubernetes API
+// server, without either implementation having to guess which kind of policy
+// a key belongs to from its shape.
+type PolicyStatusEventHandler interface {
+	// WatchForPolicyStatusEvents starts a watcher for all the status
+	// updates for this handler's policy kind from the key-value store.
+	// It blocks until ctx is cancelled.
+	WatchForPolicyStatusEvents(ctx context.Context)
+	// StartStatusHandler starts the goroutine which sends status updates
+	// for the given policy to the Kubernetes APIserver. If a status
+	// handler has already been started, it is a no-op.
+	StartStatusHandler(cnp *types.SlimCNP)
+	// StopStatusHandler signals that we need to stop managing the
+	// sending of status updates to the Kubernetes APIServer for the given
+	// policy. It also cleans up all status updates from the key-value
+	// store for this policy.
+	StopStatusHandler(cnp *types.SlimCNP)
+	// RunWithLeaderElection runs WatchForPolicyStatusEvents under leader
+	// election so that only a single operator replica watches the
+	// key-value store for this handler's policy kind at a time. It blocks
+	// until ctx is cancelled.
+	RunWithLeaderElection(ctx context.Context, lockName, identity string) error
+}
+
+// CNPStatusEventHandler handles status update events for all
+// CiliumNetworkPolicies in the cluster. Upon creation of CNPs, it will start a
+// controller for that CNP which handles sending of updates for that CNP to
+// the Kubernetes API server. Upon receiving events from the key-value store,
+// it will send the update for the CNP corresponding to the status update to
+// the controller for that CNP.
 type CNPStatusEventHandler struct {
+	*policyStatusEventHandler
+}
+
+// CCNPStatusEventHandler is the CiliumClusterwideNetworkPolicy counterpart of
+// CNPStatusEventHandler. It watches its own kvstore prefix and talks to the
+// cluster-scoped CiliumClusterwideNetworkPolicies client, so that CCNP status
+// handling no longer has to be inferred from a CNP carrying an empty
+// namespace.
+type CCNPStatusEventHandler struct {
+	*policyStatusEventHandler
+}
+
+// policyStatusEventHandler holds the state shared by CNPStatusEventHandler and
+// CCNPStatusEventHandler. The two exported types differ only in the kvstore
+// prefix they watch and the Kubernetes API calls they issue to apply a batch
+// of node statuses, both captured in this struct at construction time.
+type policyStatusEventHandler struct {
 	eventMap       *cnpEventMap
 	cnpStore       *store.SharedStore
 	k8sStore       cache.Store
 	updateInterval time.Duration
+
+	// prefix is the kvstore path this handler watches and cleans up,
+	// e.g. CNPStatusesPath or CCNPStatusesPath.
+	prefix string
+	// updateStatuses applies a batch of node statuses to the Kubernetes
+	// API server for the given policy, using whichever client
+	// (CiliumNetworkPolicies or CiliumClusterwideNetworkPolicies) and
+	// Patch/Update strategy fits this handler's policy kind and the
+	// server's capabilities.
+	updateStatuses func(cnp *types.SlimCNP, namespace, name string, nodeStatusMap map[string]cilium_v2.CiliumNetworkPolicyNodeStatus) (resourceVersion string, err error)
+
+	// limiter is shared across every per-policy runStatusHandler
+	// goroutine so that the aggregate rate of batched updates sent to the
+	// K8s API server stays bounded regardless of how many policies are
+	// active.
+	limiter *rate.Limiter
+	// minInterval and maxInterval bound the per-policy collection window
+	// used to adapt to limiter saturation; see runStatusHandler.
+	minInterval time.Duration
+	maxInterval time.Duration
+
+	// metrics is nil unless a Registry is supplied at construction; while
+	// it is nil, every metrics.* call below is skipped.
+	metrics *cnpmetrics.Registry
+	// channelHighWaterMark is the fraction (0-1) of the updater channel's
+	// capacity above which a warning is logged so operators can size the
+	// buffer or update interval.
+	channelHighWaterMark float64
 }
 
 // NodeStatusUpdater handles the lifecycle around sending CNP NodeStatus updates.
@@ -54,14 +132,19 @@ type NodeStatusUpdater struct {
 	stopChan   chan struct{}
 }
 
+// cnpEventChannelCapacity is the buffer size of each CNP's updateChan.
+const cnpEventChannelCapacity = 512
+
 type cnpEventMap struct {
 	lock.RWMutex
 	eventMap map[string]*NodeStatusUpdater
+	metrics  *cnpmetrics.Registry
 }
 
-func newCNPEventMap() *cnpEventMap {
+func newCNPEventMap(metrics *cnpmetrics.Registry) *cnpEventMap {
 	return &cnpEventMap{
 		eventMap: make(map[string]*NodeStatusUpdater),
+		metrics:  metrics,
 	}
 }
 
@@ -81,10 +164,13 @@ func (c *cnpEventMap) createIfNotExist(cnpKey string) (*NodeStatusUpdater, bool)
 		return nsu, ok
 	}
 	nsu = &NodeStatusUpdater{
-		updateChan: make(chan *NodeStatusUpdate, 512),
+		updateChan: make(chan *NodeStatusUpdate, cnpEventChannelCapacity),
 		stopChan:   make(chan struct{}),
 	}
 	c.eventMap[cnpKey] = nsu
+	if c.metrics != nil {
+		c.metrics.ActiveUpdaters.Set(float64(len(c.eventMap)))
+	}
 	return nsu, ok
 }
 
@@ -98,15 +184,124 @@ func (c *cnpEventMap) delete(cnpKey string) {
 	// Signal that we should stop processing events.
 	close(nsu.stopChan)
 	delete(c.eventMap, cnpKey)
+	if c.metrics != nil {
+		c.metrics.ActiveUpdaters.Set(float64(len(c.eventMap)))
+	}
+}
+
+// stopAll signals every running status handler to stop without removing the
+// corresponding kvstore keys, so that whichever operator replica becomes
+// leader next can resume from the kvstore state left behind.
+func (c *cnpEventMap) stopAll() {
+	c.Lock()
+	defer c.Unlock()
+	for cnpKey, nsu := range c.eventMap {
+		close(nsu.stopChan)
+		delete(c.eventMap, cnpKey)
+	}
+	if c.metrics != nil {
+		c.metrics.ActiveUpdaters.Set(0)
+	}
+}
+
+// defaultChannelHighWaterMark is the default fraction of the updater
+// channel's capacity above which a warning is logged.
+const defaultChannelHighWaterMark = 0.8
+
+const (
+	updateMethodPatch  = "patch"
+	updateMethodUpdate = "update"
+)
+
+// Defaults for the shared API server update rate limiter, used unless
+// overridden via WithRateLimit.
+const (
+	defaultQPS   = 10
+	defaultBurst = 20
+)
+
+// CCNPStatusesPath is the cluster-scoped counterpart of CNPStatusesPath. It
+// gives CCNPStatusEventHandler its own kvstore subtree so a CCNP's status
+// updates no longer have to be recognized by an empty namespace on an
+// otherwise CNP-shaped key.
+const CCNPStatusesPath = "cilium/state/ccnpstatuses/v2"
+
+// PolicyStatusEventHandlerOption configures a CNPStatusEventHandler or
+// CCNPStatusEventHandler at construction time.
+type PolicyStatusEventHandlerOption func(*policyStatusEventHandler)
+
+// WithRateLimit sets the QPS/burst of the rate limiter shared by every
+// per-policy status updater goroutine.
+func WithRateLimit(qps float64, burst int) PolicyStatusEventHandlerOption {
+	return func(c *policyStatusEventHandler) {
+		c.limiter = rate.NewLimiter(rate.Limit(qps), burst)
+	}
+}
+
+// WithUpdateIntervalBounds sets the minimum and maximum size of the per-policy
+// collection window. The window starts at min and grows towards max as the
+// shared rate limiter saturates, shrinking back towards min under low load.
+func WithUpdateIntervalBounds(min, max time.Duration) PolicyStatusEventHandlerOption {
+	return func(c *policyStatusEventHandler) {
+		c.minInterval = min
+		c.maxInterval = max
+	}
+}
+
+func newPolicyStatusEventHandler(
+	cnpStore *store.SharedStore,
+	k8sStore cache.Store,
+	updateInterval time.Duration,
+	metrics *cnpmetrics.Registry,
+	prefix string,
+	updateStatuses func(cnp *types.SlimCNP, namespace, name string, nodeStatusMap map[string]cilium_v2.CiliumNetworkPolicyNodeStatus) (resourceVersion string, err error),
+	opts []PolicyStatusEventHandlerOption,
+) *policyStatusEventHandler {
+	c := &policyStatusEventHandler{
+		eventMap:             newCNPEventMap(metrics),
+		cnpStore:             cnpStore,
+		k8sStore:             k8sStore,
+		updateInterval:       updateInterval,
+		prefix:               prefix,
+		updateStatuses:       updateStatuses,
+		metrics:              metrics,
+		channelHighWaterMark: defaultChannelHighWaterMark,
+		limiter:              rate.NewLimiter(defaultQPS, defaultBurst),
+		minInterval:          updateInterval,
+		maxInterval:          updateInterval,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
-// NewCNPStatusEventHandler returns a new CNPStatusEventHandler.
-func NewCNPStatusEventHandler(cnpStore *store.SharedStore, k8sStore cache.Store, updateInterval time.Duration) *CNPStatusEventHandler {
+// NewCNPStatusEventHandler returns a new CNPStatusEventHandler watching
+// CNPStatusesPath and talking to the namespaced CiliumNetworkPolicies client.
+// metrics may be nil, in which case no metrics are emitted.
+func NewCNPStatusEventHandler(cnpStore *store.SharedStore, k8sStore cache.Store, updateInterval time.Duration, metrics *cnpmetrics.Registry, opts ...PolicyStatusEventHandlerOption) *CNPStatusEventHandler {
+	updateStatuses := func(cnp *types.SlimCNP, namespace, name string, nodeStatusMap map[string]cilium_v2.CiliumNetworkPolicyNodeStatus) (string, error) {
+		return updateStatusesByCapabilities(CiliumClient(), k8sversion.Capabilities(), cnp, namespace, name, nodeStatusMap)
+	}
 	return &CNPStatusEventHandler{
-		eventMap:       newCNPEventMap(),
-		cnpStore:       cnpStore,
-		k8sStore:       k8sStore,
-		updateInterval: updateInterval,
+		policyStatusEventHandler: newPolicyStatusEventHandler(cnpStore, k8sStore, updateInterval, metrics, CNPStatusesPath, updateStatuses, opts),
+	}
+}
+
+// NewCCNPStatusEventHandler returns a new CCNPStatusEventHandler watching
+// CCNPStatusesPath and talking to the cluster-scoped
+// CiliumClusterwideNetworkPolicies client. metrics may be nil, in which case
+// no metrics are emitted.
+func NewCCNPStatusEventHandler(ccnpStore *store.SharedStore, k8sStore cache.Store, updateInterval time.Duration, metrics *cnpmetrics.Registry, opts ...PolicyStatusEventHandlerOption) *CCNPStatusEventHandler {
+	updateStatuses := func(cnp *types.SlimCNP, namespace, name string, nodeStatusMap map[string]cilium_v2.CiliumNetworkPolicyNodeStatus) (string, error) {
+		// CiliumClusterwideNetworkPolicies are cluster-scoped, so they are
+		// always updated with an empty namespace; updateStatusesByCapabilities
+		// already supports this (it is how CCNP status updates were applied
+		// before CCNPStatusEventHandler had its own prefix to watch).
+		return updateStatusesByCapabilities(CiliumClient(), k8sversion.Capabilities(), cnp, "", name, nodeStatusMap)
+	}
+	return &CCNPStatusEventHandler{
+		policyStatusEventHandler: newPolicyStatusEventHandler(ccnpStore, k8sStore, updateInterval, metrics, CCNPStatusesPath, updateStatuses, opts),
 	}
 }
 
@@ -116,22 +311,27 @@ type NodeStatusUpdate struct {
 	*cilium_v2.CiliumNetworkPolicyNodeStatus
 }
 
-// WatchForCNPStatusEvents starts a watcher for all the CNP update from the
-// key-value store.
-func (c *CNPStatusEventHandler) WatchForCNPStatusEvents() {
-	watcher := kvstore.Client().ListAndWatch(context.TODO(), "cnpStatusWatcher", CNPStatusesPath, 512)
+// WatchForPolicyStatusEvents starts a watcher for all the status updates for
+// this handler's policy kind from the key-value store. It returns as soon as
+// ctx is cancelled, so that a replica which loses and later re-acquires
+// leadership never runs two overlapping watchers for the same prefix.
+func (c *policyStatusEventHandler) WatchForPolicyStatusEvents(ctx context.Context) {
+	watcher := kvstore.Client().ListAndWatch(ctx, "policyStatusWatcher-"+c.prefix, c.prefix, 512)
 
-	// Loop and block for the watcher
-	for {
-		c.watchForCNPStatusEvents(watcher)
+	// Loop and block for the watcher until ctx is cancelled.
+	for ctx.Err() == nil {
+		c.watchForPolicyStatusEvents(ctx, watcher)
 	}
 }
 
-// watchForCNPStatusEvents starts responds to the events from the watcher of
-// the key-value store.
-func (c *CNPStatusEventHandler) watchForCNPStatusEvents(watcher *kvstore.Watcher) {
+// watchForPolicyStatusEvents starts responds to the events from the watcher of
+// the key-value store. It returns once ctx is cancelled or the watcher's
+// Events channel is closed.
+func (c *policyStatusEventHandler) watchForPolicyStatusEvents(ctx context.Context, watcher *kvstore.Watcher) {
 	for {
 		select {
+		case <-ctx.Done():
+			return
 		case event, ok := <-watcher.Events:
 			if !ok {
 				log.Debugf("%s closed, restarting watch", watcher.String())
@@ -145,11 +345,18 @@ func (c *CNPStatusEventHandler) watchForCNPStatusEvents(watcher *kvstore.Watcher
 				var cnpStatusUpdate CNPNSWithMeta
 				err := json.Unmarshal(event.Value, &cnpStatusUpdate)
 				if err != nil {
+					if c.metrics != nil {
+						c.metrics.EventsDecodeErrors.WithLabelValues(event.Key).Inc()
+					}
 					log.WithFields(logrus.Fields{"kvstore-event": event.Typ.String(), "key": event.Key}).
-						WithError(err).Error("Not updating CNP Status; error unmarshaling data from key-value store")
+						WithError(err).Error("Not updating policy status; error unmarshaling data from key-value store")
 					continue
 				}
 
+				if c.metrics != nil {
+					c.metrics.EventsReceived.WithLabelValues(cnpStatusUpdate.Name).Inc()
+				}
+
 				log.WithFields(logrus.Fields{
 					"uid":       cnpStatusUpdate.UID,
 					"name":      cnpStatusUpdate.Name,
@@ -160,18 +367,31 @@ func (c *CNPStatusEventHandler) watchForCNPStatusEvents(watcher *kvstore.Watcher
 				}).Debug("received event from kvstore")
 
 				// Send the update to the corresponding controller for the
-				// CNP which sends all status updates to the K8s apiserver.
-				// If the namespace is empty for the status update then the cnpKey
-				// will correspond to the ccnpKey.
+				// policy which sends all status updates to the K8s apiserver.
+				// This handler only ever watches its own prefix, so the key
+				// unambiguously belongs to a CNP or a CCNP depending on which
+				// concrete handler is running, with no namespace sniffing.
 				cnpKey := generateCNPKey(string(cnpStatusUpdate.UID), cnpStatusUpdate.Namespace, cnpStatusUpdate.Name)
 				updater, ok := c.eventMap.lookup(cnpKey)
 				if !ok {
-					log.WithField("cnp", cnpKey).Debug("received event from kvstore for cnp for which we do not have any updater goroutine")
+					if c.metrics != nil {
+						c.metrics.EventsDropped.WithLabelValues(cnpStatusUpdate.Name).Inc()
+					}
+					log.WithField("cnp", cnpKey).Debug("received event from kvstore for policy for which we do not have any updater goroutine")
 					continue
 				}
 				nsu := &NodeStatusUpdate{node: cnpStatusUpdate.Node}
 				nsu.CiliumNetworkPolicyNodeStatus = &(cnpStatusUpdate.CiliumNetworkPolicyNodeStatus)
 
+				if c.metrics != nil {
+					depth := len(updater.updateChan)
+					c.metrics.UpdaterChannelDepth.WithLabelValues(cnpStatusUpdate.Name).Set(float64(depth))
+					if float64(depth)/float64(cnpEventChannelCapacity) >= c.channelHighWaterMark {
+						log.WithFields(logrus.Fields{"cnp": cnpKey, "depth": depth, "capacity": cnpEventChannelCapacity}).
+							Warning("policy status updater channel utilization is above the configured high-water mark")
+					}
+				}
+
 				// Given that select is not deterministic, ensure that we check
 				// for shutdown first. If not shut down, then try to send on
 				// channel, or wait for shutdown so that we don't block forever
@@ -185,13 +405,22 @@ func (c *CNPStatusEventHandler) watchForCNPStatusEvents(watcher *kvstore.Watcher
 				default:
 					select {
 					// If the update is sent and we shut down after, the event
-					// is 'lost'; we don't care because this means the CNP
+					// is 'lost'; we don't care because this means the policy
 					// was deleted anyway.
 					case updater.updateChan <- nsu:
 					case <-updater.stopChan:
 						// This goroutine is the only sender on this channel; we can
 						// close safely if the stop channel is closed.
 						close(updater.updateChan)
+					default:
+						// The updater is behind by a full cnpEventChannelCapacity
+						// updates; drop this update rather than block the shared
+						// watcher goroutine on one stalled CNP. The dropped node
+						// status is lost: the updater keeps that node's previous
+						// entry until a later update for the node arrives.
+						if c.metrics != nil {
+							c.metrics.UpdaterChannelDropped.WithLabelValues(cnpStatusUpdate.Name).Inc()
+						}
 					}
 				}
 			}
@@ -199,25 +428,174 @@ func (c *CNPStatusEventHandler) watchForCNPStatusEvents(watcher *kvstore.Watcher
 	}
 }
 
-func (c *CNPStatusEventHandler) stopStatusHandler(cnp *types.SlimCNP, cnpKey, prefix string) {
+const (
+	// leaseDuration is the duration non-leader operator replicas wait
+	// before attempting to acquire leadership of the status pipeline.
+	leaseDuration = 15 * time.Second
+	// leaseRenewDeadline is how long the current leader has to renew the
+	// lease before it is considered lost.
+	leaseRenewDeadline = 10 * time.Second
+	// leaseRetryPeriod is how often clients should retry acquiring the
+	// lease.
+	leaseRetryPeriod = 2 * time.Second
+)
+
+// RunWithLeaderElection runs this handler's status event watcher under leader
+// election, using a Lease object named lockName as the resource lock so that
+// only a single operator replica, identified by identity, runs
+// WatchForPolicyStatusEvents at a time. The per-policy runStatusHandler
+// goroutines are started by StartStatusHandler whether or not this replica
+// holds the lease; losing the lease stops all of them, and they are not
+// started again when the lease is re-acquired. RunWithLeaderElection keeps
+// contending for the lease until ctx is cancelled.
+func (c *policyStatusEventHandler) RunWithLeaderElection(ctx context.Context, lockName, identity string) error {
+	lock, err := resourcelock.NewFromKubeconfig(
+		resourcelock.LeasesResourceLock,
+		metaV1.NamespaceSystem,
+		lockName,
+		resourcelock.ResourceLockConfig{Identity: identity},
+		nil,
+		leaseRenewDeadline,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create resource lock for policy status leader election: %w", err)
+	}
+
+	// RunOrDie returns as soon as this replica stops holding the lease, so
+	// contend for it again until ctx is cancelled.
+	for ctx.Err() == nil {
+		leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+			Lock:            lock,
+			ReleaseOnCancel: true,
+			LeaseDuration:   leaseDuration,
+			RenewDeadline:   leaseRenewDeadline,
+			RetryPeriod:     leaseRetryPeriod,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					log.WithField("identity", identity).Info("acquired leadership of policy status pipeline")
+					c.WatchForPolicyStatusEvents(ctx)
+				},
+				OnStoppedLeading: func() {
+					log.WithField("identity", identity).Info("lost leadership of policy status pipeline, draining in-flight batches")
+					// Close stopChan for every entry so in-flight batches drain
+					// without deleting the underlying kvstore keys; the next
+					// leader seeds nodeStatusMap from those keys on startup.
+					c.eventMap.stopAll()
+				},
+				OnNewLeader: func(identity string) {
+					log.WithField("leader", identity).Debug("new leader elected for policy status pipeline")
+				},
+			},
+		})
+	}
+
+	return nil
+}
+
+// checkpointsPrefix roots status checkpoints in a subtree disjoint from any
+// policyStatusEventHandler's watched prefix (CNPStatusesPath/
+// CCNPStatusesPath). Checkpoints must never live under c.prefix: both
+// WatchForPolicyStatusEvents's ListAndWatch and cnpStore's SharedKeysMap
+// recurse that whole subtree and would otherwise pick up a checkpoint write
+// as a bogus CNPNSWithMeta, merging a spurious empty-node entry into
+// nodeStatusMap on every resync.
+const checkpointsPrefix = "cilium/state/policystatuscheckpoints/v1"
+
+// statusCheckpoint is periodically persisted to the kvstore after a
+// successful status update so that, on operator restart or leadership
+// hand-off, the next owner of this CNP's status handler can tell which node
+// statuses have already been reflected in the API server.
+type statusCheckpoint struct {
+	// ResourceVersion is the resource version of the CNP as observed right
+	// after the checkpointed update was applied.
+	ResourceVersion string `json:"resourceVersion"`
+	// Hash is a digest of the node status map that was applied, used to
+	// detect whether the kvstore state collected on resync already
+	// matches what was last pushed to the API server.
+	Hash string `json:"hash"`
+}
+
+// checkpointKey returns the checkpoint's key for cnpKey under this handler's
+// own namespace (prefix), so CNP and CCNP checkpoints never collide, while
+// keeping the whole checkpointsPrefix subtree outside of what prefix's
+// watcher and shared store observe.
+func checkpointKey(prefix, cnpKey string) string {
+	return path.Join(checkpointsPrefix, prefix, cnpKey)
+}
+
+func hashNodeStatusMap(nodeStatusMap map[string]cilium_v2.CiliumNetworkPolicyNodeStatus) string {
+	names := make([]string, 0, len(nodeStatusMap))
+	for node := range nodeStatusMap {
+		names = append(names, node)
+	}
+	sort.Strings(names)
+
+	h := fnv.New64a()
+	for _, node := range names {
+		fmt.Fprintf(h, "%s=%+v;", node, nodeStatusMap[node])
+	}
+	return fmt.Sprintf("%x", h.Sum64())
+}
+
+// writeCheckpoint persists a statusCheckpoint for cnpKey so that a future
+// resync can tell that nodeStatusMap has already been applied at
+// resourceVersion.
+func (c *policyStatusEventHandler) writeCheckpoint(cnpKey, resourceVersion string, nodeStatusMap map[string]cilium_v2.CiliumNetworkPolicyNodeStatus) error {
+	checkpoint := statusCheckpoint{
+		ResourceVersion: resourceVersion,
+		Hash:            hashNodeStatusMap(nodeStatusMap),
+	}
+	marshalled, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("unable to marshal status checkpoint: %w", err)
+	}
+	return kvstore.Client().Update(context.TODO(), checkpointKey(c.prefix, cnpKey), marshalled, false)
+}
+
+// loadCheckpoint returns the statusCheckpoint for cnpKey, if any.
+func (c *policyStatusEventHandler) loadCheckpoint(cnpKey string) (*statusCheckpoint, bool) {
+	raw, err := kvstore.Client().Get(context.TODO(), checkpointKey(c.prefix, cnpKey))
+	if err != nil || raw == nil {
+		return nil, false
+	}
+
+	var checkpoint statusCheckpoint
+	if err := json.Unmarshal(raw, &checkpoint); err != nil {
+		log.WithError(err).WithField("cnp", cnpKey).Warning("error unmarshaling status checkpoint from kvstore")
+		return nil, false
+	}
+	return &checkpoint, true
+}
+
+// GCCheckpoints removes the status checkpoint for cnpKey. It is invoked
+// alongside the DeletePrefix cleanup in stopStatusHandler so that no stale
+// checkpoint outlives the CNP it was tracking.
+func (c *policyStatusEventHandler) GCCheckpoints(cnpKey string) {
+	if err := kvstore.Client().Delete(context.TODO(), checkpointKey(c.prefix, cnpKey)); err != nil {
+		log.WithError(err).WithField("cnp", cnpKey).Warning("error deleting stale status checkpoint from kvstore")
+	}
+}
+
+func (c *policyStatusEventHandler) stopStatusHandler(cnp *types.SlimCNP, cnpKey, prefix string) {
 	err := kvstore.DeletePrefix(context.TODO(), prefix)
 	if err != nil {
 		log.WithError(err).WithField("prefix", prefix).Warning("error deleting prefix from kvstore")
 	}
+	c.GCCheckpoints(cnpKey)
 	c.eventMap.delete(cnpKey)
 }
 
 // StopStatusHandler signals that we need to stop managing the sending of
-// status updates to the Kubernetes APIServer for the given CNP. It also cleans
-// up all status updates from the key-value store for this CNP.
-func (c *CNPStatusEventHandler) StopStatusHandler(cnp *types.SlimCNP) {
+// status updates to the Kubernetes APIServer for the given policy. It also
+// cleans up all status updates from the key-value store for this policy.
+func (c *policyStatusEventHandler) StopStatusHandler(cnp *types.SlimCNP) {
 	cnpKey := getKeyFromObjectMeta(cnp.ObjectMeta)
-	prefix := path.Join(CNPStatusesPath, cnpKey)
+	prefix := path.Join(c.prefix, cnpKey)
 
 	c.stopStatusHandler(cnp, cnpKey, prefix)
 }
 
-func (c *CNPStatusEventHandler) runStatusHandler(cnpKey string, cnp *types.SlimCNP, nodeStatusUpdater *NodeStatusUpdater) {
+func (c *policyStatusEventHandler) runStatusHandler(cnpKey string, cnp *types.SlimCNP, nodeStatusUpdater *NodeStatusUpdater) {
 	namespace := cnp.Namespace
 	name := cnp.Name
 	nodeStatusMap := make(map[string]cilium_v2.CiliumNetworkPolicyNodeStatus)
@@ -230,32 +608,50 @@ func (c *CNPStatusEventHandler) runStatusHandler(cnpKey string, cnp *types.SlimC
 	scopedLog.Debug("started status handler")
 
 	// Iterate over the shared-store first. We may have received events for this
-	// CNP in the key-value store from nodes which received and processed this
-	// CNP and sent status updates for it before the watcher which updates this
-	// `CNPStatusEventHandler` did. Given that we have the shared store which
+	// policy in the key-value store from nodes which received and processed
+	// this policy and sent status updates for it before the watcher which
+	// updates this handler did. Given that we have the shared store which
 	// caches all keys / values from the kvstore, we iterate and collect said
 	// events. Given that this function is called after we have updated the
-	// `eventMap` for this `CNPStatusEventHandler`, subsequent key updates from
-	// the kvstore are guaranteed to be sent on the channel in the
-	// `nodeStatusUpdater`, which we will receive in the for-loop below.
+	// `eventMap` for this handler, subsequent key updates from the kvstore
+	// are guaranteed to be sent on the channel in the `nodeStatusUpdater`,
+	// which we will receive in the for-loop below.
 	sharedKeys := c.cnpStore.SharedKeysMap()
 	for keyName, storeKey := range sharedKeys {
-		// Look for any key which matches this CNP.
+		// Look for any key which matches this policy.
 		if strings.HasPrefix(keyName, cnpKey) {
 			cnpns, ok := storeKey.(*CNPNSWithMeta)
 			if !ok {
-				scopedLog.Errorf("received unexpected type mapping to key %s in cnp shared store: %T", keyName, storeKey)
+				scopedLog.Errorf("received unexpected type mapping to key %s in shared store: %T", keyName, storeKey)
 				continue
 			}
 			// extract nodeName from keyName
 			nodeStatusMap[cnpns.Node] = cnpns.CiliumNetworkPolicyNodeStatus
 		}
 	}
+
+	// If a checkpoint from a previous leader/restart shows these exact node
+	// statuses have already been reflected in the API server, skip
+	// re-patching them on startup; only genuinely newer entries arriving on
+	// nodeStatusUpdater.updateChan afterwards will trigger a flush.
+	if checkpoint, ok := c.loadCheckpoint(cnpKey); ok && checkpoint.Hash == hashNodeStatusMap(nodeStatusMap) {
+		scopedLog.WithField("resourceVersion", checkpoint.ResourceVersion).
+			Debug("resuming from checkpoint, node statuses already reflected in API server")
+		nodeStatusMap = make(map[string]cilium_v2.CiliumNetworkPolicyNodeStatus)
+	}
+
+	// window is the current collection window for this policy. It starts
+	// at minInterval and grows towards maxInterval whenever the shared
+	// rate limiter is saturated, so that hundreds of policies converging
+	// at once coalesce into fewer, larger batches instead of a thundering
+	// herd of PATCH requests; it shrinks back towards minInterval under
+	// low load.
+	window := c.minInterval
 	for {
 		// Allow for a bunch of different node status updates to come before
 		// we break out to avoid jitter in updates across the cluster
 		// to affect batching on our end.
-		limit := time.After(c.updateInterval)
+		limit := time.After(window)
 
 		// Collect any other events that have come in, but bail out after the
 		// above limit is hit so that we can send the updates we have received.
@@ -289,35 +685,77 @@ func (c *CNPStatusEventHandler) runStatusHandler(cnpKey string, cnp *types.SlimC
 		}
 
 		var (
-			cnp *types.SlimCNP
-			err error
+			updatedCNP *types.SlimCNP
+			err        error
 		)
 
 		switch {
-		// Patching doesn't need us to get the CNP from
+		// Patching doesn't need us to get the policy from
 		// the store because we can perform patches without
-		// needing the actual CNP object itself.
+		// needing the actual object itself.
 		case k8sversion.Capabilities().Patch:
 		default:
-			cnp, err = getUpdatedCNPFromStore(c.k8sStore, namespace, name)
+			updatedCNP, err = getUpdatedCNPFromStore(c.k8sStore, namespace, name)
 			if err != nil {
-				scopedLog.WithError(err).Error("error getting updated cnp from store")
+				scopedLog.WithError(err).Error("error getting updated policy from store")
+			}
+		}
+
+		updateMethod := updateMethodUpdate
+		if k8sversion.Capabilities().Patch {
+			updateMethod = updateMethodPatch
+		}
+
+		if c.metrics != nil {
+			c.metrics.BatchSize.Observe(float64(len(nodeStatusMap)))
+		}
+
+		// Coalesce to the latest status per node before flushing; entries
+		// arriving on updateChan already overwrite by node, so nodeStatusMap
+		// only ever holds the most recent CiliumNetworkPolicyNodeStatus.
+
+		// Widen or narrow the collection window based on whether the shared
+		// limiter is currently saturated, then wait for our turn to update
+		// the API server.
+		if reservation := c.limiter.Reserve(); reservation.Delay() > 0 {
+			reservation.Cancel()
+			if window *= 2; window > c.maxInterval {
+				window = c.maxInterval
+			}
+			if err := c.limiter.Wait(context.TODO()); err != nil {
+				scopedLog.WithError(err).Error("error waiting on rate limiter for policy status update")
+			}
+		} else {
+			if window /= 2; window < c.minInterval {
+				window = c.minInterval
+			}
+		}
+
+		start := time.Now()
+		// Now that we have collected all events for the given policy,
+		// update the status for all nodes which have sent us updates.
+		resourceVersion, err := c.updateStatuses(updatedCNP, namespace, name, nodeStatusMap)
+		if c.metrics != nil {
+			c.metrics.APICallLatency.WithLabelValues(updateMethod).Observe(time.Since(start).Seconds())
+		}
+		if err != nil {
+			if c.metrics != nil {
+				c.metrics.APICallErrors.WithLabelValues(updateMethod).Inc()
 			}
+			scopedLog.WithError(err).Error("error updating status for policy")
+			continue
 		}
 
-		// Now that we have collected all events for
-		// the given CNP, update the status for all nodes
-		// which have sent us updates.
-		if err = updateStatusesByCapabilities(CiliumClient(), k8sversion.Capabilities(), cnp, namespace, name, nodeStatusMap); err != nil {
-			scopedLog.WithError(err).Error("error updating status for CNP")
+		if err := c.writeCheckpoint(cnpKey, resourceVersion, nodeStatusMap); err != nil {
+			scopedLog.WithError(err).Warning("error writing status checkpoint to kvstore")
 		}
 	}
 }
 
 // StartStatusHandler starts the goroutine which sends status updates for the
-// given CNP to the Kubernetes APIserver. If a status handler has already been
-// started, it is a no-op.
-func (c *CNPStatusEventHandler) StartStatusHandler(cnp *types.SlimCNP) {
+// given policy to the Kubernetes APIserver. If a status handler has already
+// been started, it is a no-op.
+func (c *policyStatusEventHandler) StartStatusHandler(cnp *types.SlimCNP) {
 	cnpKey := generateCNPKey(string(cnp.UID), cnp.Namespace, cnp.Name)
 	nodeStatusUpdater, ok := c.eventMap.createIfNotExist(cnpKey)
 	if ok {
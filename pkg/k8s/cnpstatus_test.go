@@ -0,0 +1,127 @@
+// Copyright 2019 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"path"
+	"strings"
+	"testing"
+
+	cilium_v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+)
+
+func TestCheckpointKeyDisjointFromPrefix(t *testing.T) {
+	const watchedPrefix = "cilium/state/cnpstatuses/v2"
+
+	key := checkpointKey(watchedPrefix, "foo/bar")
+	watched := path.Join(watchedPrefix, "foo/bar")
+	if strings.HasPrefix(key, watchedPrefix) || key == watched {
+		t.Fatalf("checkpointKey(%q, %q) = %q must not live under the watched prefix %q", watchedPrefix, "foo/bar", key, watchedPrefix)
+	}
+
+	cnpKey := checkpointKey(watchedPrefix, "baz")
+	ccnpKey := checkpointKey(CCNPStatusesPath, "baz")
+	if cnpKey == ccnpKey {
+		t.Fatalf("checkpointKey must not collide across prefixes: CNP %q == CCNP %q", cnpKey, ccnpKey)
+	}
+}
+
+func TestHashNodeStatusMapIsOrderIndependent(t *testing.T) {
+	m := map[string]cilium_v2.CiliumNetworkPolicyNodeStatus{
+		"node-a": {OK: true},
+		"node-b": {OK: false},
+	}
+
+	// Map iteration order is randomized by the runtime; hashing the same
+	// contents repeatedly must always produce the same digest.
+	want := hashNodeStatusMap(m)
+	for i := 0; i < 10; i++ {
+		if got := hashNodeStatusMap(m); got != want {
+			t.Fatalf("hashNodeStatusMap is not deterministic: got %q, want %q", got, want)
+		}
+	}
+}
+
+func TestHashNodeStatusMapDetectsChange(t *testing.T) {
+	before := map[string]cilium_v2.CiliumNetworkPolicyNodeStatus{
+		"node-a": {OK: true},
+	}
+	after := map[string]cilium_v2.CiliumNetworkPolicyNodeStatus{
+		"node-a": {OK: false},
+	}
+
+	if hashNodeStatusMap(before) == hashNodeStatusMap(after) {
+		t.Fatal("hashNodeStatusMap must change when a node's status changes")
+	}
+}
+
+func TestCNPEventMapCreateLookupDelete(t *testing.T) {
+	m := newCNPEventMap(nil)
+
+	if _, ok := m.lookup("cnp-a"); ok {
+		t.Fatal("lookup on an empty map must report not-found")
+	}
+
+	nsu, existed := m.createIfNotExist("cnp-a")
+	if existed {
+		t.Fatal("createIfNotExist must report false for a new key")
+	}
+
+	if got, ok := m.lookup("cnp-a"); !ok || got != nsu {
+		t.Fatal("lookup after createIfNotExist must return the same updater")
+	}
+
+	if _, existed := m.createIfNotExist("cnp-a"); !existed {
+		t.Fatal("createIfNotExist must not replace an already-active updater")
+	}
+
+	m.delete("cnp-a")
+	if _, ok := m.lookup("cnp-a"); ok {
+		t.Fatal("lookup after delete must report not-found")
+	}
+
+	select {
+	case _, ok := <-nsu.stopChan:
+		if ok {
+			t.Fatal("delete must close stopChan, not send on it")
+		}
+	default:
+		t.Fatal("delete must close stopChan so in-flight senders observe shutdown")
+	}
+}
+
+func TestCNPEventMapStopAllClosesEveryStopChan(t *testing.T) {
+	m := newCNPEventMap(nil)
+
+	nsuA, _ := m.createIfNotExist("cnp-a")
+	nsuB, _ := m.createIfNotExist("cnp-b")
+
+	m.stopAll()
+
+	for key, nsu := range map[string]*NodeStatusUpdater{"cnp-a": nsuA, "cnp-b": nsuB} {
+		select {
+		case _, ok := <-nsu.stopChan:
+			if ok {
+				t.Fatalf("stopAll must close %s's stopChan, not send on it", key)
+			}
+		default:
+			t.Fatalf("stopAll must close %s's stopChan", key)
+		}
+	}
+
+	if _, ok := m.lookup("cnp-a"); ok {
+		t.Fatal("stopAll must remove every entry from the map")
+	}
+}
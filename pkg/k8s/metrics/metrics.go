@@ -0,0 +1,129 @@
+// Copyright 2019 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics exposes Prometheus metrics for the CNP status update
+// pipeline (pkg/k8s.CNPStatusEventHandler and NodeStatusUpdater).
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// LabelUpdateMethod distinguishes the K8s API call used to apply a
+	// status update.
+	LabelUpdateMethod = "method"
+	// LabelOutcome distinguishes successful from failed API calls.
+	LabelOutcome = "outcome"
+
+	updateMethodPatch  = "patch"
+	updateMethodUpdate = "update"
+
+	outcomeSuccess = "success"
+	outcomeFailure = "failure"
+)
+
+// Registry groups together the metrics emitted by the CNP status update
+// pipeline. Registry has no recording methods of its own: callers use its
+// metrics directly, so a caller holding a nil *Registry has to check for nil
+// before recording anything.
+type Registry struct {
+	EventsReceived     *prometheus.CounterVec
+	EventsDecodeErrors *prometheus.CounterVec
+	EventsDropped      *prometheus.CounterVec
+
+	UpdaterChannelDepth   *prometheus.GaugeVec
+	UpdaterChannelDropped *prometheus.CounterVec
+	ActiveUpdaters        prometheus.Gauge
+
+	BatchSize      prometheus.Histogram
+	APICallLatency *prometheus.HistogramVec
+	APICallErrors  *prometheus.CounterVec
+}
+
+// NewRegistry creates a Registry and registers all of its metrics with reg.
+func NewRegistry(reg prometheus.Registerer) *Registry {
+	r := &Registry{
+		EventsReceived: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "cilium_operator",
+			Subsystem: "cnp_status",
+			Name:      "events_received_total",
+			Help:      "Number of CNP status events received from the kvstore",
+		}, []string{"cnp"}),
+		EventsDecodeErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "cilium_operator",
+			Subsystem: "cnp_status",
+			Name:      "events_decode_errors_total",
+			Help:      "Number of CNP status events that failed to unmarshal",
+		}, []string{"cnp"}),
+		EventsDropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "cilium_operator",
+			Subsystem: "cnp_status",
+			Name:      "events_dropped_total",
+			Help:      "Number of CNP status events dropped because no updater goroutine exists for the CNP",
+		}, []string{"cnp"}),
+		UpdaterChannelDepth: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "cilium_operator",
+			Subsystem: "cnp_status",
+			Name:      "updater_channel_depth",
+			Help:      "Current number of buffered node status updates awaiting batching for a CNP",
+		}, []string{"cnp"}),
+		UpdaterChannelDropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "cilium_operator",
+			Subsystem: "cnp_status",
+			Name:      "updater_channel_dropped_total",
+			Help:      "Number of node status updates dropped because the updater channel was full",
+		}, []string{"cnp"}),
+		ActiveUpdaters: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "cilium_operator",
+			Subsystem: "cnp_status",
+			Name:      "active_updaters",
+			Help:      "Number of CNPs that currently have a running status updater goroutine",
+		}),
+		BatchSize: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "cilium_operator",
+			Subsystem: "cnp_status",
+			Name:      "batch_size",
+			Help:      "Number of node status entries included in a flushed batch",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		}),
+		APICallLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "cilium_operator",
+			Subsystem: "cnp_status",
+			Name:      "api_call_duration_seconds",
+			Help:      "Duration of K8s API calls used to apply CNP status updates",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{LabelUpdateMethod}),
+		APICallErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "cilium_operator",
+			Subsystem: "cnp_status",
+			Name:      "api_call_errors_total",
+			Help:      "Number of failed K8s API calls used to apply CNP status updates",
+		}, []string{LabelUpdateMethod}),
+	}
+
+	reg.MustRegister(
+		r.EventsReceived,
+		r.EventsDecodeErrors,
+		r.EventsDropped,
+		r.UpdaterChannelDepth,
+		r.UpdaterChannelDropped,
+		r.ActiveUpdaters,
+		r.BatchSize,
+		r.APICallLatency,
+		r.APICallErrors,
+	)
+
+	return r
+}